@@ -0,0 +1,167 @@
+package gostl
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned by the blocking containers' PushCtx and PopCtx once
+// Close has been called and, for PopCtx, no buffered values remain.
+var ErrClosed = errors.New("gostl: closed")
+
+// BQOption is a function type used to set a BlockingQueue's initial state
+type BQOption[T any] func(q *BlockingQueue[T])
+
+// WithInitialValues pre-fills a BlockingQueue with vals (oldest first)
+func WithInitialValues[T any](vals ...T) BQOption[T] {
+	return func(q *BlockingQueue[T]) {
+		q.buf = append(q.buf, vals...)
+	}
+}
+
+// BlockingQueue is a fixed-capacity, first-in-first-out queue that blocks
+// producers while full and consumers while empty. Unlike Queue, whose
+// Locker abstraction only guards access, BlockingQueue uses a sync.Mutex
+// with a pair of sync.Cond (not-full/not-empty) so callers can actually
+// wait for room or for a value, optionally bounded by a context.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	buf      []T
+	capacity int
+	closed   bool
+}
+
+// NewBlockingQueue creates a new BlockingQueue that holds at most capacity
+// values
+func NewBlockingQueue[T any](capacity int, opts ...BQOption[T]) *BlockingQueue[T] {
+	q := &BlockingQueue[T]{capacity: capacity}
+	q.notEmpty = sync.NewCond(&q.mu)
+	q.notFull = sync.NewCond(&q.mu)
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// Size returns the amount of elements currently buffered in the queue
+func (q *BlockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.buf)
+}
+
+// Empty returns true if the queue currently holds no elements
+func (q *BlockingQueue[T]) Empty() bool {
+	return q.Size() == 0
+}
+
+// PushCtx pushes v to the back of the queue, blocking while the queue is
+// full until room is available, ctx is done, or the queue is closed
+func (q *BlockingQueue[T]) PushCtx(ctx context.Context, v T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.notFull.Broadcast()
+	})
+	defer stop()
+
+	for !q.closed && len(q.buf) >= q.capacity {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		q.notFull.Wait()
+	}
+	if q.closed {
+		return ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	q.buf = append(q.buf, v)
+	q.notEmpty.Signal()
+	return nil
+}
+
+// PopCtx removes and returns the value at the front of the queue, blocking
+// while the queue is empty until a value arrives, ctx is done, or the queue
+// is closed. It returns ErrClosed once the queue is closed and empty.
+func (q *BlockingQueue[T]) PopCtx(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.notEmpty.Broadcast()
+	})
+	defer stop()
+
+	for len(q.buf) == 0 {
+		if q.closed {
+			return *new(T), ErrClosed
+		}
+		if err := ctx.Err(); err != nil {
+			return *new(T), err
+		}
+		q.notEmpty.Wait()
+	}
+
+	v := q.buf[0]
+	q.buf = q.buf[1:]
+	q.notFull.Signal()
+	return v, nil
+}
+
+// TryPush pushes v to the back of the queue without blocking, returning
+// false if the queue is full or closed
+func (q *BlockingQueue[T]) TryPush(v T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed || len(q.buf) >= q.capacity {
+		return false
+	}
+
+	q.buf = append(q.buf, v)
+	q.notEmpty.Signal()
+	return true
+}
+
+// TryPop removes and returns the value at the front of the queue without
+// blocking, returning false if the queue is currently empty
+func (q *BlockingQueue[T]) TryPop() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.buf) == 0 {
+		return *new(T), false
+	}
+
+	v := q.buf[0]
+	q.buf = q.buf[1:]
+	q.notFull.Signal()
+	return v, true
+}
+
+// Close closes the queue, waking every blocked PushCtx/PopCtx call with
+// ErrClosed (PopCtx still drains any values buffered before Close). Close
+// is idempotent.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
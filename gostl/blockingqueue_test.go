@@ -0,0 +1,94 @@
+package gostl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueueTryPushPop(t *testing.T) {
+	q := NewBlockingQueue[int](2)
+
+	if !q.TryPush(1) || !q.TryPush(2) {
+		t.Fatalf("expected first two pushes to succeed")
+	}
+	if q.TryPush(3) {
+		t.Fatalf("expected push to a full queue to fail")
+	}
+
+	v, ok := q.TryPop()
+	if !ok || v != 1 {
+		t.Fatalf("expected to pop 1, got %v, %v", v, ok)
+	}
+}
+
+func TestBlockingQueuePushCtxBlocksUntilRoom(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	if err := q.PushCtx(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- q.PushCtx(context.Background(), 2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("expected PushCtx to block while the queue is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if _, err := q.PopCtx(context.Background()); err != nil {
+		t.Fatalf("unexpected error popping: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected blocked PushCtx to unblock once room was freed")
+	}
+}
+
+func TestBlockingQueuePopCtxCancel(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := q.PopCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	q.Close()
+
+	if err := q.PushCtx(context.Background(), 1); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+	if _, err := q.PopCtx(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestBlockingQueueCloseDrainsBuffered(t *testing.T) {
+	q := NewBlockingQueue[int](2, WithInitialValues(1, 2))
+	q.Close()
+
+	v, err := q.PopCtx(context.Background())
+	if err != nil || v != 1 {
+		t.Fatalf("expected to drain buffered value 1, got %v, %v", v, err)
+	}
+	v, err = q.PopCtx(context.Background())
+	if err != nil || v != 2 {
+		t.Fatalf("expected to drain buffered value 2, got %v, %v", v, err)
+	}
+	if _, err := q.PopCtx(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed once drained, got %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package gostl
+
+import (
+	"context"
+	"sync"
+)
+
+// BSOption is a function type used to set a BlockingStack's initial state
+type BSOption[T any] func(s *BlockingStack[T])
+
+// WithInitialStackValues pre-fills a BlockingStack with vals (vals[len-1]
+// ends up on top)
+func WithInitialStackValues[T any](vals ...T) BSOption[T] {
+	return func(s *BlockingStack[T]) {
+		s.buf = append(s.buf, vals...)
+	}
+}
+
+// BlockingStack is a fixed-capacity, last-in-first-out stack that blocks
+// producers while full and consumers while empty, following the same
+// Mutex/Cond design as BlockingQueue.
+type BlockingStack[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	buf      []T
+	capacity int
+	closed   bool
+}
+
+// NewBlockingStack creates a new BlockingStack that holds at most capacity
+// values
+func NewBlockingStack[T any](capacity int, opts ...BSOption[T]) *BlockingStack[T] {
+	s := &BlockingStack[T]{capacity: capacity}
+	s.notEmpty = sync.NewCond(&s.mu)
+	s.notFull = sync.NewCond(&s.mu)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Size returns the amount of elements currently buffered in the stack
+func (s *BlockingStack[T]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.buf)
+}
+
+// Empty returns true if the stack currently holds no elements
+func (s *BlockingStack[T]) Empty() bool {
+	return s.Size() == 0
+}
+
+// PushCtx pushes v onto the top of the stack, blocking while the stack is
+// full until room is available, ctx is done, or the stack is closed
+func (s *BlockingStack[T]) PushCtx(ctx context.Context, v T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.notFull.Broadcast()
+	})
+	defer stop()
+
+	for !s.closed && len(s.buf) >= s.capacity {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s.notFull.Wait()
+	}
+	if s.closed {
+		return ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.buf = append(s.buf, v)
+	s.notEmpty.Signal()
+	return nil
+}
+
+// PopCtx removes and returns the value on top of the stack, blocking while
+// the stack is empty until a value arrives, ctx is done, or the stack is
+// closed. It returns ErrClosed once the stack is closed and empty.
+func (s *BlockingStack[T]) PopCtx(ctx context.Context) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.notEmpty.Broadcast()
+	})
+	defer stop()
+
+	for len(s.buf) == 0 {
+		if s.closed {
+			return *new(T), ErrClosed
+		}
+		if err := ctx.Err(); err != nil {
+			return *new(T), err
+		}
+		s.notEmpty.Wait()
+	}
+
+	top := len(s.buf) - 1
+	v := s.buf[top]
+	s.buf = s.buf[:top]
+	s.notFull.Signal()
+	return v, nil
+}
+
+// TryPush pushes v onto the top of the stack without blocking, returning
+// false if the stack is full or closed
+func (s *BlockingStack[T]) TryPush(v T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed || len(s.buf) >= s.capacity {
+		return false
+	}
+
+	s.buf = append(s.buf, v)
+	s.notEmpty.Signal()
+	return true
+}
+
+// TryPop removes and returns the value on top of the stack without
+// blocking, returning false if the stack is currently empty
+func (s *BlockingStack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.buf) == 0 {
+		return *new(T), false
+	}
+
+	top := len(s.buf) - 1
+	v := s.buf[top]
+	s.buf = s.buf[:top]
+	s.notFull.Signal()
+	return v, true
+}
+
+// Close closes the stack, waking every blocked PushCtx/PopCtx call with
+// ErrClosed (PopCtx still drains any values buffered before Close). Close
+// is idempotent.
+func (s *BlockingStack[T]) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.notEmpty.Broadcast()
+	s.notFull.Broadcast()
+}
@@ -0,0 +1,94 @@
+package gostl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBlockingStackTryPushPop(t *testing.T) {
+	s := NewBlockingStack[int](2)
+
+	if !s.TryPush(1) || !s.TryPush(2) {
+		t.Fatalf("expected first two pushes to succeed")
+	}
+	if s.TryPush(3) {
+		t.Fatalf("expected push to a full stack to fail")
+	}
+
+	v, ok := s.TryPop()
+	if !ok || v != 2 {
+		t.Fatalf("expected to pop 2, got %v, %v", v, ok)
+	}
+}
+
+func TestBlockingStackPushCtxBlocksUntilRoom(t *testing.T) {
+	s := NewBlockingStack[int](1)
+	if err := s.PushCtx(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.PushCtx(context.Background(), 2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("expected PushCtx to block while the stack is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if _, err := s.PopCtx(context.Background()); err != nil {
+		t.Fatalf("unexpected error popping: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected blocked PushCtx to unblock once room was freed")
+	}
+}
+
+func TestBlockingStackPopCtxCancel(t *testing.T) {
+	s := NewBlockingStack[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := s.PopCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestBlockingStackClose(t *testing.T) {
+	s := NewBlockingStack[int](1)
+	s.Close()
+
+	if err := s.PushCtx(context.Background(), 1); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+	if _, err := s.PopCtx(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestBlockingStackCloseDrainsBuffered(t *testing.T) {
+	s := NewBlockingStack[int](2, WithInitialStackValues(1, 2))
+	s.Close()
+
+	v, err := s.PopCtx(context.Background())
+	if err != nil || v != 2 {
+		t.Fatalf("expected to drain buffered value 2 first, got %v, %v", v, err)
+	}
+	v, err = s.PopCtx(context.Background())
+	if err != nil || v != 1 {
+		t.Fatalf("expected to drain buffered value 1 second, got %v, %v", v, err)
+	}
+	if _, err := s.PopCtx(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed once drained, got %v", err)
+	}
+}
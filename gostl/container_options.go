@@ -0,0 +1,20 @@
+package gostl
+
+import (
+	"github.com/RajjjAryan/gostl/ds/container"
+	"github.com/RajjjAryan/gostl/ds/list/bidlist"
+	"github.com/RajjjAryan/gostl/ds/ringbuf"
+)
+
+// newListContainer returns a bidlist-backed container, shared by Queue's
+// and Stack's WithListContainer option.
+func newListContainer[T any]() container.Container[T] {
+	return bidlist.New[T]()
+}
+
+// newRingBufferContainer returns a container backed by a ring buffer
+// preallocated to capacity, shared by Queue's and Stack's
+// WithRingBufferContainer option.
+func newRingBufferContainer[T any](capacity int) container.Container[T] {
+	return ringbuf.New[T](capacity)
+}
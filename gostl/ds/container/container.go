@@ -0,0 +1,55 @@
+// Package container holds the small interfaces and helpers shared across
+// gostl's containers, so a data structure can depend on "how to compare
+// two keys" without every caller having to hand-build a comparator
+// closure for each instantiation.
+package container
+
+import "golang.org/x/exp/constraints"
+
+// Container is the double-ended backing store Queue and Stack delegate to,
+// so either one can be backed by whichever container fits the workload
+// (deque, bidlist, a ring buffer, ...) via WithContainer or
+// WithStackContainer.
+type Container[T any] interface {
+	Size() int
+	Empty() bool
+	// PushBack appends v and reports whether it was accepted. Unbounded
+	// containers always return true; a fixed-capacity container returns
+	// false once full instead of growing.
+	PushBack(v T) bool
+	Front() T
+	Back() T
+	PopFront() T
+	PopBack() T
+	Clear()
+	String() string
+}
+
+// Ordered is implemented by a key type that knows how to compare itself
+// against another value of the same type.
+type Ordered[T any] interface {
+	// Compare returns a negative number if the receiver is less than other,
+	// zero if they are equal, and a positive number if it is greater.
+	Compare(other T) int
+}
+
+// NativeCompare compares two values of a constraints.Ordered type using
+// the built-in <, == operators.
+func NativeCompare[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ReverseCompare reverses the ordering produced by cmp, turning an
+// ascending comparator into a descending one and vice versa.
+func ReverseCompare[T any](cmp func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp(b, a)
+	}
+}
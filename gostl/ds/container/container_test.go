@@ -0,0 +1,29 @@
+package container
+
+import "testing"
+
+func TestNativeCompare(t *testing.T) {
+	if NativeCompare(1, 2) >= 0 {
+		t.Fatalf("expected 1 < 2 to compare negative")
+	}
+	if NativeCompare(2, 1) <= 0 {
+		t.Fatalf("expected 2 > 1 to compare positive")
+	}
+	if NativeCompare(1, 1) != 0 {
+		t.Fatalf("expected 1 == 1 to compare zero")
+	}
+}
+
+func TestReverseCompare(t *testing.T) {
+	rev := ReverseCompare(NativeCompare[int])
+
+	if rev(1, 2) <= 0 {
+		t.Fatalf("expected reversed comparator to put 1 after 2")
+	}
+	if rev(2, 1) >= 0 {
+		t.Fatalf("expected reversed comparator to put 2 before 1")
+	}
+	if rev(1, 1) != 0 {
+		t.Fatalf("expected 1 == 1 to compare zero under reversal")
+	}
+}
@@ -0,0 +1,155 @@
+// Package intervaltree provides an interval tree layered on top of
+// gostl's RbTree. Every node's key is an interval [Min, Max], ordered
+// lexicographically by Min then Max, and every node additionally carries
+// the largest Max endpoint anywhere in its subtree (MaxEnd). SearchOverlap
+// and Traverse use MaxEnd to prune subtrees that cannot possibly contain
+// an overlapping interval.
+package intervaltree
+
+import "github.com/RajjjAryan/gostl"
+
+// Comparator compares two bounds of type K, returning a negative number if
+// a < b, zero if a == b, and a positive number if a > b.
+type Comparator[K any] func(a, b K) int
+
+// Interval is a closed interval [Min, Max] used as a tree key.
+type Interval[K any] struct {
+	Min K
+	Max K
+}
+
+// augValue is the RbTree value actually stored for each node: the user's
+// value plus the subtree's augmented MaxEnd, kept side by side so OnUpdate
+// can recompute MaxEnd through the ordinary Node.SetValue path instead of
+// an external, leak-prone side table.
+type augValue[K, V any] struct {
+	val    V
+	maxEnd K
+}
+
+// Node is a single node of a Tree, wrapping the underlying RbTree node.
+type Node[K, V any] struct {
+	inner *gostl.Node[Interval[K], augValue[K, V]]
+}
+
+// Interval returns the interval stored at n
+func (n *Node[K, V]) Interval() Interval[K] {
+	return n.inner.Key()
+}
+
+// Value returns the value stored at n
+func (n *Node[K, V]) Value() V {
+	return n.inner.Value().val
+}
+
+// SetValue sets the value stored at n
+func (n *Node[K, V]) SetValue(v V) {
+	av := n.inner.Value()
+	av.val = v
+	n.inner.SetValue(av)
+}
+
+// Tree is an interval tree built on gostl.RbTree.
+type Tree[K, V any] struct {
+	cmp Comparator[K]
+	rb  *gostl.RbTree[Interval[K], augValue[K, V]]
+}
+
+// New creates an empty interval tree that orders bounds with cmp.
+func New[K, V any](cmp Comparator[K]) *Tree[K, V] {
+	t := &Tree[K, V]{cmp: cmp}
+	t.rb = gostl.NewWithAugmentor[Interval[K], augValue[K, V]](t.keyCmp, t)
+	return t
+}
+
+func (t *Tree[K, V]) keyCmp(a, b Interval[K]) int {
+	if c := t.cmp(a.Min, b.Min); c != 0 {
+		return c
+	}
+	return t.cmp(a.Max, b.Max)
+}
+
+// OnUpdate implements gostl.Augmentor. It recomputes n's MaxEnd from its own
+// Max endpoint and the MaxEnd already stored on its children.
+func (t *Tree[K, V]) OnUpdate(n, left, right *gostl.Node[Interval[K], augValue[K, V]]) {
+	av := n.Value()
+	max := n.Key().Max
+	if left != nil {
+		if e := left.Value().maxEnd; t.cmp(e, max) > 0 {
+			max = e
+		}
+	}
+	if right != nil {
+		if e := right.Value().maxEnd; t.cmp(e, max) > 0 {
+			max = e
+		}
+	}
+	av.maxEnd = max
+	n.SetValue(av)
+}
+
+// Size returns the number of intervals in the tree
+func (t *Tree[K, V]) Size() int {
+	return t.rb.Size()
+}
+
+// Empty returns true if the tree holds no intervals
+func (t *Tree[K, V]) Empty() bool {
+	return t.rb.Empty()
+}
+
+// Insert inserts the interval [min, max] with value v
+func (t *Tree[K, V]) Insert(min, max K, v V) {
+	t.rb.Insert(Interval[K]{Min: min, Max: max}, augValue[K, V]{val: v, maxEnd: max})
+}
+
+// Delete removes node from the tree
+func (t *Tree[K, V]) Delete(node *Node[K, V]) {
+	t.rb.Delete(node.inner)
+}
+
+// SearchPoint returns every node whose interval contains p
+func (t *Tree[K, V]) SearchPoint(p K) []*Node[K, V] {
+	return t.SearchOverlap(p, p)
+}
+
+// SearchOverlap returns every node whose interval overlaps [min, max]
+func (t *Tree[K, V]) SearchOverlap(min, max K) []*Node[K, V] {
+	var out []*Node[K, V]
+	t.Traverse(min, max, func(n *Node[K, V]) bool {
+		out = append(out, n)
+		return true
+	})
+	return out
+}
+
+// Traverse visits every node overlapping [min, max], in ascending Min
+// order, stopping early if visitor returns false. It uses each subtree's
+// MaxEnd to skip subtrees that cannot contain an overlapping interval.
+func (t *Tree[K, V]) Traverse(min, max K, visitor func(n *Node[K, V]) bool) {
+	t.traverse(t.rb.Root(), min, max, visitor)
+}
+
+func (t *Tree[K, V]) traverse(n *gostl.Node[Interval[K], augValue[K, V]], min, max K, visitor func(n *Node[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	if t.cmp(n.Value().maxEnd, min) < 0 {
+		// No interval in this subtree ends at or after min.
+		return true
+	}
+	if !t.traverse(n.Left(), min, max, visitor) {
+		return false
+	}
+	if t.cmp(n.Key().Min, max) <= 0 && t.cmp(min, n.Key().Max) <= 0 {
+		if !visitor(&Node[K, V]{inner: n}) {
+			return false
+		}
+	}
+	if t.cmp(n.Key().Min, max) > 0 {
+		// Every interval in the right subtree starts after max, and since
+		// they're ordered by Min, none of them can overlap either.
+		return true
+	}
+	return t.traverse(n.Right(), min, max, visitor)
+}
@@ -0,0 +1,144 @@
+package intervaltree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/RajjjAryan/gostl"
+)
+
+func intCmp(a, b int) int {
+	return a - b
+}
+
+// naiveOverlap is a linear-scan reference implementation of SearchOverlap.
+func naiveOverlap(intervals []Interval[int], min, max int) []Interval[int] {
+	var out []Interval[int]
+	for _, iv := range intervals {
+		if iv.Min <= max && min <= iv.Max {
+			out = append(out, iv)
+		}
+	}
+	return out
+}
+
+func sortIntervals(ivs []Interval[int]) {
+	sort.Slice(ivs, func(i, j int) bool {
+		if ivs[i].Min != ivs[j].Min {
+			return ivs[i].Min < ivs[j].Min
+		}
+		return ivs[i].Max < ivs[j].Max
+	})
+}
+
+func TestTreeSearchOverlapAgainstNaive(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 200; trial++ {
+		tree := New[int, int](intCmp)
+		var intervals []Interval[int]
+
+		n := rnd.Intn(30)
+		for i := 0; i < n; i++ {
+			min := rnd.Intn(100)
+			max := min + rnd.Intn(20)
+			tree.Insert(min, max, i)
+			intervals = append(intervals, Interval[int]{Min: min, Max: max})
+		}
+
+		for q := 0; q < 10; q++ {
+			qmin := rnd.Intn(100)
+			qmax := qmin + rnd.Intn(20)
+
+			want := naiveOverlap(intervals, qmin, qmax)
+			sortIntervals(want)
+
+			var got []Interval[int]
+			for _, node := range tree.SearchOverlap(qmin, qmax) {
+				got = append(got, node.Interval())
+			}
+			sortIntervals(got)
+
+			if len(want) != len(got) {
+				t.Fatalf("trial %d query [%d,%d]: want %v, got %v", trial, qmin, qmax, want, got)
+			}
+			for i := range want {
+				if want[i] != got[i] {
+					t.Fatalf("trial %d query [%d,%d]: want %v, got %v", trial, qmin, qmax, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestTreeSearchPoint(t *testing.T) {
+	tree := New[int, string](intCmp)
+	tree.Insert(1, 5, "a")
+	tree.Insert(4, 10, "b")
+	tree.Insert(20, 30, "c")
+
+	got := tree.SearchPoint(4)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 intervals containing 4, got %d", len(got))
+	}
+
+	got = tree.SearchPoint(15)
+	if len(got) != 0 {
+		t.Fatalf("expected no intervals containing 15, got %d", len(got))
+	}
+}
+
+// checkMaxEnd recursively verifies that every node's stored MaxEnd equals
+// the true maximum Max endpoint across its own interval and both subtrees,
+// failing the test if any augmented value has gone stale.
+func checkMaxEnd[K, V any](t *testing.T, cmp Comparator[K], n *gostl.Node[Interval[K], augValue[K, V]]) K {
+	t.Helper()
+	var zero K
+	if n == nil {
+		return zero
+	}
+	want := n.Key().Max
+	if l := checkMaxEnd(t, cmp, n.Left()); n.Left() != nil && cmp(l, want) > 0 {
+		want = l
+	}
+	if r := checkMaxEnd(t, cmp, n.Right()); n.Right() != nil && cmp(r, want) > 0 {
+		want = r
+	}
+	if got := n.Value().maxEnd; cmp(got, want) != 0 {
+		t.Fatalf("node %v has stale MaxEnd %v, want %v", n.Key(), got, want)
+	}
+	return n.Value().maxEnd
+}
+
+func TestMaxEndStaysCorrectAfterRotations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	tree := New[int, int](intCmp)
+
+	for i := 0; i < 500; i++ {
+		min := rnd.Intn(200)
+		max := min + rnd.Intn(50)
+		tree.Insert(min, max, i)
+		checkMaxEnd(t, intCmp, tree.rb.Root())
+	}
+
+	for tree.Size() > 0 {
+		tree.rb.Delete(tree.rb.First())
+		checkMaxEnd(t, intCmp, tree.rb.Root())
+	}
+}
+
+func TestTreeDelete(t *testing.T) {
+	tree := New[int, string](intCmp)
+	tree.Insert(1, 5, "a")
+	node := tree.rb.FindNode(Interval[int]{Min: 1, Max: 5})
+	wrapped := &Node[int, string]{inner: node}
+
+	tree.Delete(wrapped)
+	if tree.Size() != 0 {
+		t.Fatalf("expected empty tree after delete, got size %d", tree.Size())
+	}
+	if got := tree.SearchPoint(1); len(got) != 0 {
+		t.Fatalf("expected no matches after delete, got %v", got)
+	}
+}
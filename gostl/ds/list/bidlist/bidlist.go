@@ -0,0 +1,129 @@
+// Package bidlist implements a doubly linked list as a
+// container.Container[T] backing store for Queue/Stack. Node allocations
+// are pooled with sync.Pool, so a long-running queue or stack churning
+// through push/pop traffic reuses nodes instead of generating garbage on
+// every operation.
+package bidlist
+
+import (
+	"fmt"
+	"sync"
+)
+
+type node[T any] struct {
+	val        T
+	prev, next *node[T]
+}
+
+// List is an unbounded doubly linked list implementing
+// container.Container[T]
+type List[T any] struct {
+	pool       *sync.Pool
+	head, tail *node[T]
+	size       int
+}
+
+// New creates an empty List
+func New[T any]() *List[T] {
+	return &List[T]{pool: &sync.Pool{New: func() any { return new(node[T]) }}}
+}
+
+func (l *List[T]) newNode(v T) *node[T] {
+	n := l.pool.Get().(*node[T])
+	n.val = v
+	n.prev, n.next = nil, nil
+	return n
+}
+
+func (l *List[T]) releaseNode(n *node[T]) {
+	var zero T
+	n.val = zero
+	n.prev, n.next = nil, nil
+	l.pool.Put(n)
+}
+
+// Size returns the amount of elements in the list
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// Empty returns true if the list holds no elements
+func (l *List[T]) Empty() bool {
+	return l.size == 0
+}
+
+// PushBack appends v to the back of the list and reports true. List is
+// unbounded, so PushBack never rejects a value.
+func (l *List[T]) PushBack(v T) bool {
+	n := l.newNode(v)
+	if l.tail == nil {
+		l.head, l.tail = n, n
+	} else {
+		n.prev = l.tail
+		l.tail.next = n
+		l.tail = n
+	}
+	l.size++
+	return true
+}
+
+// Front returns the value at the front of the list
+func (l *List[T]) Front() T {
+	return l.head.val
+}
+
+// Back returns the value at the back of the list
+func (l *List[T]) Back() T {
+	return l.tail.val
+}
+
+// PopFront removes and returns the value at the front of the list
+func (l *List[T]) PopFront() T {
+	n := l.head
+	v := n.val
+	l.head = n.next
+	if l.head != nil {
+		l.head.prev = nil
+	} else {
+		l.tail = nil
+	}
+	l.size--
+	l.releaseNode(n)
+	return v
+}
+
+// PopBack removes and returns the value at the back of the list
+func (l *List[T]) PopBack() T {
+	n := l.tail
+	v := n.val
+	l.tail = n.prev
+	if l.tail != nil {
+		l.tail.next = nil
+	} else {
+		l.head = nil
+	}
+	l.size--
+	l.releaseNode(n)
+	return v
+}
+
+// Clear removes every element from the list, returning their nodes to the
+// pool
+func (l *List[T]) Clear() {
+	for n := l.head; n != nil; {
+		next := n.next
+		l.releaseNode(n)
+		n = next
+	}
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// String returns a string representation of the list's elements, front to
+// back
+func (l *List[T]) String() string {
+	elems := make([]T, 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		elems = append(elems, n.val)
+	}
+	return fmt.Sprint(elems)
+}
@@ -0,0 +1,72 @@
+package bidlist
+
+import "testing"
+
+func TestPushPopFIFO(t *testing.T) {
+	l := New[int]()
+
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	if l.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", l.Size())
+	}
+	if got := l.PopFront(); got != 1 {
+		t.Fatalf("expected 1, got %d", got)
+	}
+	if got := l.PopFront(); got != 2 {
+		t.Fatalf("expected 2, got %d", got)
+	}
+	if got := l.PopFront(); got != 3 {
+		t.Fatalf("expected 3, got %d", got)
+	}
+	if !l.Empty() {
+		t.Fatalf("expected list to be empty")
+	}
+}
+
+func TestPushPopBack(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	if got := l.PopBack(); got != 3 {
+		t.Fatalf("expected 3, got %d", got)
+	}
+	if got := l.Back(); got != 2 {
+		t.Fatalf("expected back to be 2, got %d", got)
+	}
+	if got := l.Front(); got != 1 {
+		t.Fatalf("expected front to be 1, got %d", got)
+	}
+}
+
+func TestClearReusesNodesViaPool(t *testing.T) {
+	l := New[int]()
+	for i := 0; i < 5; i++ {
+		l.PushBack(i)
+	}
+	l.Clear()
+	if !l.Empty() || l.Size() != 0 {
+		t.Fatalf("expected list to be empty after Clear")
+	}
+
+	for i := 0; i < 5; i++ {
+		l.PushBack(i)
+	}
+	var got []int
+	for !l.Empty() {
+		got = append(got, l.PopFront())
+	}
+	want := []int{0, 1, 2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
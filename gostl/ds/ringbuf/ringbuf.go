@@ -0,0 +1,99 @@
+// Package ringbuf provides a fixed-capacity circular buffer implementing
+// container.Container[T], for Queue/Stack workloads that want amortized
+// O(1) push/pop with no per-op allocation and a hard bound on memory use,
+// unlike a linked list (bidlist) or a growing slice-of-slices (deque).
+// PushBack rejects a value once the buffer is full instead of growing.
+package ringbuf
+
+import "fmt"
+
+// RingBuffer is a fixed-capacity circular buffer: once full, PushBack
+// rejects further values rather than growing past the capacity given to
+// New.
+type RingBuffer[T any] struct {
+	buf        []T
+	head, tail int
+	size       int
+}
+
+// New creates a RingBuffer fixed at capacity elements
+func New[T any](capacity int) *RingBuffer[T] {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity)}
+}
+
+// Size returns the amount of elements currently buffered
+func (r *RingBuffer[T]) Size() int {
+	return r.size
+}
+
+// Empty returns true if the buffer currently holds no elements
+func (r *RingBuffer[T]) Empty() bool {
+	return r.size == 0
+}
+
+// PushBack appends v to the back of the buffer and reports true, or
+// reports false without modifying the buffer if it is already at capacity
+func (r *RingBuffer[T]) PushBack(v T) bool {
+	if r.size == len(r.buf) {
+		return false
+	}
+	r.buf[r.tail] = v
+	r.tail = (r.tail + 1) % len(r.buf)
+	r.size++
+	return true
+}
+
+// Front returns the value at the front of the buffer
+func (r *RingBuffer[T]) Front() T {
+	return r.buf[r.head]
+}
+
+// Back returns the value at the back of the buffer
+func (r *RingBuffer[T]) Back() T {
+	return r.buf[r.backIndex()]
+}
+
+func (r *RingBuffer[T]) backIndex() int {
+	return (r.tail - 1 + len(r.buf)) % len(r.buf)
+}
+
+// PopFront removes and returns the value at the front of the buffer
+func (r *RingBuffer[T]) PopFront() T {
+	v := r.buf[r.head]
+	var zero T
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return v
+}
+
+// PopBack removes and returns the value at the back of the buffer
+func (r *RingBuffer[T]) PopBack() T {
+	idx := r.backIndex()
+	v := r.buf[idx]
+	var zero T
+	r.buf[idx] = zero
+	r.tail = idx
+	r.size--
+	return v
+}
+
+// Clear removes every element from the buffer, keeping its current
+// capacity
+func (r *RingBuffer[T]) Clear() {
+	r.buf = make([]T, len(r.buf))
+	r.head, r.tail, r.size = 0, 0, 0
+}
+
+// String returns a string representation of the buffer's elements, front
+// to back
+func (r *RingBuffer[T]) String() string {
+	elems := make([]T, 0, r.size)
+	for i := 0; i < r.size; i++ {
+		elems = append(elems, r.buf[(r.head+i)%len(r.buf)])
+	}
+	return fmt.Sprint(elems)
+}
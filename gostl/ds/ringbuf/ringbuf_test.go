@@ -0,0 +1,68 @@
+package ringbuf
+
+import "testing"
+
+func TestRingBufferPushPop(t *testing.T) {
+	r := New[int](2)
+
+	if !r.PushBack(1) || !r.PushBack(2) {
+		t.Fatalf("expected first two pushes to succeed")
+	}
+	if r.PushBack(3) {
+		t.Fatalf("expected push to a full ring buffer to be rejected")
+	}
+
+	if r.Size() != 2 {
+		t.Fatalf("expected size 2, got %d", r.Size())
+	}
+	if got := r.PopFront(); got != 1 {
+		t.Fatalf("expected 1, got %d", got)
+	}
+	if got := r.PopFront(); got != 2 {
+		t.Fatalf("expected 2, got %d", got)
+	}
+	if !r.Empty() {
+		t.Fatalf("expected buffer to be empty")
+	}
+}
+
+func TestRingBufferWrapAround(t *testing.T) {
+	r := New[int](4)
+
+	for i := 0; i < 4; i++ {
+		r.PushBack(i)
+	}
+	r.PopFront()
+	r.PopFront()
+	r.PushBack(4)
+	r.PushBack(5) // tail has now wrapped past the end of buf, head has not
+
+	var got []int
+	for !r.Empty() {
+		got = append(got, r.PopFront())
+	}
+
+	want := []int{2, 3, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestRingBufferPopBack(t *testing.T) {
+	r := New[int](4)
+	r.PushBack(1)
+	r.PushBack(2)
+	r.PushBack(3)
+
+	if got := r.PopBack(); got != 3 {
+		t.Fatalf("expected 3, got %d", got)
+	}
+	if got := r.Back(); got != 2 {
+		t.Fatalf("expected back to be 2, got %d", got)
+	}
+}
@@ -0,0 +1,231 @@
+// Package sortedmap wraps gostl's RbTree in a Go-idiomatic, ordered map
+// API (Get/Put/Delete, iter.Seq ranging, sync.Map-style Load/Store), so
+// callers don't have to hand-roll this every time around the raw tree.
+// Unlike sync.Map, Map is not safe for concurrent use; the sync.Map-style
+// names are offered purely for familiarity, and callers sharing a Map
+// across goroutines must still provide their own locking.
+package sortedmap
+
+import (
+	"iter"
+
+	"github.com/RajjjAryan/gostl"
+	"github.com/RajjjAryan/gostl/ds/container"
+	"golang.org/x/exp/constraints"
+)
+
+// Comparator compares two keys, returning a negative number if a < b, zero
+// if a == b, and a positive number if a > b.
+type Comparator[K any] func(a, b K) int
+
+// Option configures a Map at construction time
+type Option[K any] func(cmp Comparator[K]) Comparator[K]
+
+// WithDescending reverses the map's ordering, so iteration and Min/Max
+// swap places
+func WithDescending[K any]() Option[K] {
+	return func(cmp Comparator[K]) Comparator[K] {
+		return container.ReverseCompare(cmp)
+	}
+}
+
+// Map is an ordered map backed by an RbTree. Unlike the raw RbTree, Put
+// replaces the value of an existing key instead of inserting a duplicate
+// node. V is constrained to comparable so Map can offer CompareAndSwap,
+// mirroring sync.Map. Map itself holds no lock and is not safe for
+// concurrent use.
+type Map[K any, V comparable] struct {
+	rb  *gostl.RbTree[K, V]
+	cmp Comparator[K]
+}
+
+// New creates a Map ordering keys with cmp
+func New[K any, V comparable](cmp Comparator[K], opts ...Option[K]) *Map[K, V] {
+	for _, opt := range opts {
+		cmp = opt(cmp)
+	}
+	return &Map[K, V]{rb: gostl.New[K, V](gostl.Comparator[K](cmp)), cmp: cmp}
+}
+
+// NewNative creates a Map ordering a constraints.Ordered key type with <
+// and ==
+func NewNative[K constraints.Ordered, V comparable](opts ...Option[K]) *Map[K, V] {
+	return New[K, V](container.NativeCompare[K], opts...)
+}
+
+// NewOrdered creates a Map ordering a container.Ordered key type, so
+// callers don't have to build a Comparator closure by hand.
+func NewOrdered[K container.Ordered[K], V comparable](opts ...Option[K]) *Map[K, V] {
+	return New[K, V](func(a, b K) int { return a.Compare(b) }, opts...)
+}
+
+// Size returns the number of entries in the map
+func (m *Map[K, V]) Size() int {
+	return m.rb.Size()
+}
+
+// Empty returns true if the map holds no entries
+func (m *Map[K, V]) Empty() bool {
+	return m.rb.Empty()
+}
+
+// Clear removes every entry from the map
+func (m *Map[K, V]) Clear() {
+	m.rb.Clear()
+}
+
+// Get returns the value stored for k, and whether it was present
+func (m *Map[K, V]) Get(k K) (V, bool) {
+	n := m.rb.FindNode(k)
+	if n == nil {
+		return *new(V), false
+	}
+	return n.Value(), true
+}
+
+// Put stores v for k, replacing any existing value, and returns the value
+// it replaced (if any)
+func (m *Map[K, V]) Put(k K, v V) (V, bool) {
+	if n := m.rb.FindNode(k); n != nil {
+		old := n.Value()
+		n.SetValue(v)
+		return old, true
+	}
+	m.rb.Insert(k, v)
+	return *new(V), false
+}
+
+// Delete removes k from the map, and reports whether it was present
+func (m *Map[K, V]) Delete(k K) bool {
+	n := m.rb.FindNode(k)
+	if n == nil {
+		return false
+	}
+	m.rb.Delete(n)
+	return true
+}
+
+// Keys returns a sequence over the map's keys in ascending (or, with
+// WithDescending, descending) order
+func (m *Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := m.rb.First(); n != nil; n = n.Next() {
+			if !yield(n.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence over the map's values, in key order
+func (m *Map[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for n := m.rb.First(); n != nil; n = n.Next() {
+			if !yield(n.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a sequence over the map's key-value pairs, in key order
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := m.rb.First(); n != nil; n = n.Next() {
+			if !yield(n.Key(), n.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns a sequence over the key-value pairs with keys in
+// [lo, hi), in key order
+func (m *Map[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := m.rb.FindLowerBoundNode(lo); n != nil && m.cmp(n.Key(), hi) < 0; n = n.Next() {
+			if !yield(n.Key(), n.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Load returns the value stored for k, and whether it was present. It is
+// equivalent to Get, offered under sync.Map's name for familiarity; unlike
+// sync.Map.Load, it is not safe to call concurrently with other methods.
+func (m *Map[K, V]) Load(k K) (V, bool) {
+	return m.Get(k)
+}
+
+// Store sets the value for k, replacing any existing value
+func (m *Map[K, V]) Store(k K, v V) {
+	m.Put(k, v)
+}
+
+// LoadOrStore returns the existing value for k if present; otherwise it
+// stores and returns v. loaded reports whether v was loaded rather than
+// stored.
+func (m *Map[K, V]) LoadOrStore(k K, v V) (actual V, loaded bool) {
+	if n := m.rb.FindNode(k); n != nil {
+		return n.Value(), true
+	}
+	m.rb.Insert(k, v)
+	return v, false
+}
+
+// Swap stores v for k and returns the value it replaced, if any
+func (m *Map[K, V]) Swap(k K, v V) (previous V, loaded bool) {
+	return m.Put(k, v)
+}
+
+// CompareAndSwap stores new for k only if k's current value equals old,
+// and reports whether it did
+func (m *Map[K, V]) CompareAndSwap(k K, old, new V) bool {
+	n := m.rb.FindNode(k)
+	if n == nil || n.Value() != old {
+		return false
+	}
+	n.SetValue(new)
+	return true
+}
+
+// Min returns the map's lowest-keyed entry
+func (m *Map[K, V]) Min() (k K, v V, ok bool) {
+	n := m.rb.First()
+	if n == nil {
+		return k, v, false
+	}
+	return n.Key(), n.Value(), true
+}
+
+// Max returns the map's highest-keyed entry
+func (m *Map[K, V]) Max() (k K, v V, ok bool) {
+	n := m.rb.Last()
+	if n == nil {
+		return k, v, false
+	}
+	return n.Key(), n.Value(), true
+}
+
+// PopMin removes and returns the map's lowest-keyed entry
+func (m *Map[K, V]) PopMin() (k K, v V, ok bool) {
+	n := m.rb.First()
+	if n == nil {
+		return k, v, false
+	}
+	k, v = n.Key(), n.Value()
+	m.rb.Delete(n)
+	return k, v, true
+}
+
+// PopMax removes and returns the map's highest-keyed entry
+func (m *Map[K, V]) PopMax() (k K, v V, ok bool) {
+	n := m.rb.Last()
+	if n == nil {
+		return k, v, false
+	}
+	k, v = n.Key(), n.Value()
+	m.rb.Delete(n)
+	return k, v, true
+}
@@ -0,0 +1,165 @@
+package sortedmap
+
+import "testing"
+
+func TestPutReplacesExistingKey(t *testing.T) {
+	m := NewNative[int, string]()
+
+	if _, existed := m.Put(1, "a"); existed {
+		t.Fatalf("expected first put to report no existing value")
+	}
+	old, existed := m.Put(1, "b")
+	if !existed || old != "a" {
+		t.Fatalf("expected put to replace existing value, got %q, %v", old, existed)
+	}
+	if m.Size() != 1 {
+		t.Fatalf("expected a single entry after replacing a duplicate key, got %d", m.Size())
+	}
+
+	v, ok := m.Get(1)
+	if !ok || v != "b" {
+		t.Fatalf("expected Get to return the replaced value, got %q, %v", v, ok)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	m := NewNative[int, string]()
+	m.Put(1, "a")
+
+	if !m.Delete(1) {
+		t.Fatalf("expected delete of an existing key to succeed")
+	}
+	if m.Delete(1) {
+		t.Fatalf("expected delete of a missing key to fail")
+	}
+	if _, ok := m.Get(1); ok {
+		t.Fatalf("expected key to be gone after delete")
+	}
+}
+
+func TestKeysAndRangeOrdering(t *testing.T) {
+	m := NewNative[int, string]()
+	for _, k := range []int{5, 1, 3, 4, 2} {
+		m.Put(k, "v")
+	}
+
+	var keys []int
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	want := []int{1, 2, 3, 4, 5}
+	assertIntsEqual(t, keys, want)
+
+	var ranged []int
+	for k := range m.Range(2, 5) {
+		ranged = append(ranged, k)
+	}
+	assertIntsEqual(t, ranged, []int{2, 3, 4})
+}
+
+func TestDescendingOrder(t *testing.T) {
+	m := NewNative[int, string](WithDescending[int]())
+	for _, k := range []int{1, 2, 3} {
+		m.Put(k, "v")
+	}
+
+	var keys []int
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	assertIntsEqual(t, keys, []int{3, 2, 1})
+
+	k, _, ok := m.Min()
+	if !ok || k != 3 {
+		t.Fatalf("expected descending Min to be 3, got %d", k)
+	}
+}
+
+func TestLoadOrStoreAndCompareAndSwap(t *testing.T) {
+	m := NewNative[int, string]()
+
+	actual, loaded := m.LoadOrStore(1, "a")
+	if loaded || actual != "a" {
+		t.Fatalf("expected first LoadOrStore to store, got %q, %v", actual, loaded)
+	}
+	actual, loaded = m.LoadOrStore(1, "b")
+	if !loaded || actual != "a" {
+		t.Fatalf("expected second LoadOrStore to load existing value, got %q, %v", actual, loaded)
+	}
+
+	if m.CompareAndSwap(1, "wrong", "c") {
+		t.Fatalf("expected CompareAndSwap to fail on a mismatched old value")
+	}
+	if !m.CompareAndSwap(1, "a", "c") {
+		t.Fatalf("expected CompareAndSwap to succeed on a matching old value")
+	}
+	if v, _ := m.Get(1); v != "c" {
+		t.Fatalf("expected value to be swapped to %q, got %q", "c", v)
+	}
+}
+
+func TestPopMinPopMax(t *testing.T) {
+	m := NewNative[int, string]()
+	for _, k := range []int{3, 1, 2} {
+		m.Put(k, "v")
+	}
+
+	k, _, ok := m.PopMin()
+	if !ok || k != 1 {
+		t.Fatalf("expected PopMin to return 1, got %d", k)
+	}
+	k, _, ok = m.PopMax()
+	if !ok || k != 3 {
+		t.Fatalf("expected PopMax to return 3, got %d", k)
+	}
+	if m.Size() != 1 {
+		t.Fatalf("expected one entry left, got %d", m.Size())
+	}
+}
+
+// orderedInt wraps int to implement container.Ordered[orderedInt].
+type orderedInt int
+
+func (o orderedInt) Compare(other orderedInt) int {
+	switch {
+	case o < other:
+		return -1
+	case o > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestNewOrderedUsesCompare(t *testing.T) {
+	m := NewOrdered[orderedInt, string]()
+	for _, k := range []orderedInt{3, 1, 2} {
+		m.Put(k, "v")
+	}
+
+	var keys []orderedInt
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	want := []orderedInt{1, 2, 3}
+	if len(keys) != len(want) {
+		t.Fatalf("got %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Fatalf("got %v, want %v", keys, want)
+		}
+	}
+}
+
+func assertIntsEqual(t *testing.T, got, want []int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Package sortedset wraps gostl's RbTree in a Go-idiomatic, ordered set
+// API (Add/Remove/Contains, iter.Seq ranging), mirroring ds/sortedmap's
+// pattern for callers who only need keys and no associated value.
+package sortedset
+
+import (
+	"iter"
+
+	"github.com/RajjjAryan/gostl"
+	"github.com/RajjjAryan/gostl/ds/container"
+	"golang.org/x/exp/constraints"
+)
+
+// Comparator compares two elements, returning a negative number if a < b,
+// zero if a == b, and a positive number if a > b.
+type Comparator[K any] func(a, b K) int
+
+// Option configures a Set at construction time
+type Option[K any] func(cmp Comparator[K]) Comparator[K]
+
+// WithDescending reverses the set's ordering, so iteration and Min/Max
+// swap places
+func WithDescending[K any]() Option[K] {
+	return func(cmp Comparator[K]) Comparator[K] {
+		return container.ReverseCompare(cmp)
+	}
+}
+
+// Set is an ordered set backed by an RbTree.
+type Set[K any] struct {
+	rb  *gostl.RbTree[K, struct{}]
+	cmp Comparator[K]
+}
+
+// New creates a Set ordering elements with cmp
+func New[K any](cmp Comparator[K], opts ...Option[K]) *Set[K] {
+	for _, opt := range opts {
+		cmp = opt(cmp)
+	}
+	return &Set[K]{rb: gostl.New[K, struct{}](gostl.Comparator[K](cmp)), cmp: cmp}
+}
+
+// NewNative creates a Set ordering a constraints.Ordered element type with
+// < and ==
+func NewNative[K constraints.Ordered](opts ...Option[K]) *Set[K] {
+	return New[K](container.NativeCompare[K], opts...)
+}
+
+// NewOrdered creates a Set ordering a container.Ordered element type, so
+// callers don't have to build a Comparator closure by hand.
+func NewOrdered[K container.Ordered[K]](opts ...Option[K]) *Set[K] {
+	return New[K](func(a, b K) int { return a.Compare(b) }, opts...)
+}
+
+// Size returns the number of elements in the set
+func (s *Set[K]) Size() int {
+	return s.rb.Size()
+}
+
+// Empty returns true if the set holds no elements
+func (s *Set[K]) Empty() bool {
+	return s.rb.Empty()
+}
+
+// Clear removes every element from the set
+func (s *Set[K]) Clear() {
+	s.rb.Clear()
+}
+
+// Contains reports whether k is in the set
+func (s *Set[K]) Contains(k K) bool {
+	return s.rb.FindNode(k) != nil
+}
+
+// Add inserts k into the set, and reports whether it was not already
+// present
+func (s *Set[K]) Add(k K) bool {
+	if s.rb.FindNode(k) != nil {
+		return false
+	}
+	s.rb.Insert(k, struct{}{})
+	return true
+}
+
+// Remove removes k from the set, and reports whether it was present
+func (s *Set[K]) Remove(k K) bool {
+	n := s.rb.FindNode(k)
+	if n == nil {
+		return false
+	}
+	s.rb.Delete(n)
+	return true
+}
+
+// All returns a sequence over the set's elements in ascending (or, with
+// WithDescending, descending) order
+func (s *Set[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := s.rb.First(); n != nil; n = n.Next() {
+			if !yield(n.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns a sequence over the elements in [lo, hi), in order
+func (s *Set[K]) Range(lo, hi K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := s.rb.FindLowerBoundNode(lo); n != nil && s.cmp(n.Key(), hi) < 0; n = n.Next() {
+			if !yield(n.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// Min returns the set's lowest element
+func (s *Set[K]) Min() (k K, ok bool) {
+	n := s.rb.First()
+	if n == nil {
+		return k, false
+	}
+	return n.Key(), true
+}
+
+// Max returns the set's highest element
+func (s *Set[K]) Max() (k K, ok bool) {
+	n := s.rb.Last()
+	if n == nil {
+		return k, false
+	}
+	return n.Key(), true
+}
+
+// PopMin removes and returns the set's lowest element
+func (s *Set[K]) PopMin() (k K, ok bool) {
+	n := s.rb.First()
+	if n == nil {
+		return k, false
+	}
+	k = n.Key()
+	s.rb.Delete(n)
+	return k, true
+}
+
+// PopMax removes and returns the set's highest element
+func (s *Set[K]) PopMax() (k K, ok bool) {
+	n := s.rb.Last()
+	if n == nil {
+		return k, false
+	}
+	k = n.Key()
+	s.rb.Delete(n)
+	return k, true
+}
@@ -0,0 +1,131 @@
+package sortedset
+
+import "testing"
+
+func TestAddContainsRemove(t *testing.T) {
+	s := NewNative[int]()
+
+	if !s.Add(1) {
+		t.Fatalf("expected first add to report newly inserted")
+	}
+	if s.Add(1) {
+		t.Fatalf("expected second add of the same element to report already present")
+	}
+	if !s.Contains(1) {
+		t.Fatalf("expected set to contain 1")
+	}
+
+	if !s.Remove(1) {
+		t.Fatalf("expected remove of an existing element to succeed")
+	}
+	if s.Remove(1) {
+		t.Fatalf("expected remove of a missing element to fail")
+	}
+	if s.Contains(1) {
+		t.Fatalf("expected set to no longer contain 1")
+	}
+}
+
+func TestAllAndRangeOrdering(t *testing.T) {
+	s := NewNative[int]()
+	for _, k := range []int{5, 1, 3, 4, 2} {
+		s.Add(k)
+	}
+
+	var got []int
+	for k := range s.All() {
+		got = append(got, k)
+	}
+	want := []int{1, 2, 3, 4, 5}
+	assertIntsEqual(t, got, want)
+
+	var ranged []int
+	for k := range s.Range(2, 5) {
+		ranged = append(ranged, k)
+	}
+	assertIntsEqual(t, ranged, []int{2, 3, 4})
+}
+
+func TestDescendingOrder(t *testing.T) {
+	s := NewNative[int](WithDescending[int]())
+	for _, k := range []int{1, 2, 3} {
+		s.Add(k)
+	}
+
+	var got []int
+	for k := range s.All() {
+		got = append(got, k)
+	}
+	assertIntsEqual(t, got, []int{3, 2, 1})
+
+	k, ok := s.Min()
+	if !ok || k != 3 {
+		t.Fatalf("expected descending Min to be 3, got %d", k)
+	}
+}
+
+func TestPopMinPopMax(t *testing.T) {
+	s := NewNative[int]()
+	for _, k := range []int{3, 1, 2} {
+		s.Add(k)
+	}
+
+	k, ok := s.PopMin()
+	if !ok || k != 1 {
+		t.Fatalf("expected PopMin to return 1, got %d", k)
+	}
+	k, ok = s.PopMax()
+	if !ok || k != 3 {
+		t.Fatalf("expected PopMax to return 3, got %d", k)
+	}
+	if s.Size() != 1 {
+		t.Fatalf("expected one element left, got %d", s.Size())
+	}
+}
+
+// orderedInt wraps int to implement container.Ordered[orderedInt].
+type orderedInt int
+
+func (o orderedInt) Compare(other orderedInt) int {
+	switch {
+	case o < other:
+		return -1
+	case o > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestNewOrderedUsesCompare(t *testing.T) {
+	s := NewOrdered[orderedInt]()
+	for _, k := range []orderedInt{3, 1, 2} {
+		s.Add(k)
+	}
+
+	var got []orderedInt
+	for k := range s.All() {
+		got = append(got, k)
+	}
+	want := []orderedInt{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func assertIntsEqual(t *testing.T, got, want []int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
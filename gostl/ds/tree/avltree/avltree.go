@@ -0,0 +1,446 @@
+// Package avltree implements a height-balanced AVL tree with the same
+// public surface as gostl's RbTree, for callers who want tighter balance
+// (and so faster lookups) at the cost of more rotations on write. Pick
+// RbTree for write-heavy workloads and AvlTree for read-heavy ones.
+package avltree
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RajjjAryan/gostl/internal/treeiter"
+)
+
+// ErrorNotFound is returned by Find when no node has the requested key
+var ErrorNotFound = errors.New("not found")
+
+// Comparator compares two keys, returning a negative number if a < b, zero
+// if a == b, and a positive number if a > b.
+type Comparator[K any] func(a, b K) int
+
+// Node is an AVL tree node
+type Node[K, V any] struct {
+	parent *Node[K, V]
+	left   *Node[K, V]
+	right  *Node[K, V]
+	height int8
+	key    K
+	value  V
+}
+
+// Key returns node's key
+func (n *Node[K, V]) Key() K {
+	return n.key
+}
+
+// Value returns node's value
+func (n *Node[K, V]) Value() V {
+	return n.value
+}
+
+// SetValue sets node's value
+func (n *Node[K, V]) SetValue(val V) {
+	n.value = val
+}
+
+// Parent returns the Node's parent, or nil if n is the root
+func (n *Node[K, V]) Parent() *Node[K, V] {
+	return n.parent
+}
+
+// Left returns the Node's left child, or nil if it has none
+func (n *Node[K, V]) Left() *Node[K, V] {
+	return n.left
+}
+
+// Right returns the Node's right child, or nil if it has none
+func (n *Node[K, V]) Right() *Node[K, V] {
+	return n.right
+}
+
+// Next returns the Node's successor
+func (n *Node[K, V]) Next() *Node[K, V] {
+	return treeiter.Successor[*Node[K, V]](n)
+}
+
+// Prev returns the Node's predecessor
+func (n *Node[K, V]) Prev() *Node[K, V] {
+	return treeiter.Predecessor[*Node[K, V]](n)
+}
+
+// height returns n's stored height, treating a nil child as height 0
+func height[K, V any](n *Node[K, V]) int8 {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// updateHeight recomputes n's height from its children's, which must
+// already be up to date
+func (n *Node[K, V]) updateHeight() {
+	l, r := height(n.left), height(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+}
+
+// AvlTreeIterator is an iterator implementation of AvlTree. It wraps the
+// tree-shape-agnostic iterator in treeiter, which RbTreeIterator wraps too.
+type AvlTreeIterator[K, V any] struct {
+	*treeiter.Iterator[*Node[K, V], K, V]
+}
+
+// NewIterator creates an AvlTreeIterator from the passed node
+func NewIterator[K, V any](node *Node[K, V]) *AvlTreeIterator[K, V] {
+	return &AvlTreeIterator[K, V]{treeiter.NewIterator[*Node[K, V], K, V](node)}
+}
+
+// Next moves the iterator to its successor, and returns itself
+func (it *AvlTreeIterator[K, V]) Next() *AvlTreeIterator[K, V] {
+	it.Iterator.Next()
+	return it
+}
+
+// Prev moves the iterator to its predecessor, and returns itself
+func (it *AvlTreeIterator[K, V]) Prev() *AvlTreeIterator[K, V] {
+	it.Iterator.Prev()
+	return it
+}
+
+// Clone clones the iterator into a new, independent AvlTreeIterator
+func (it *AvlTreeIterator[K, V]) Clone() *AvlTreeIterator[K, V] {
+	return &AvlTreeIterator[K, V]{it.Iterator.Clone()}
+}
+
+// Equal returns true if it and other point to the same node
+func (it *AvlTreeIterator[K, V]) Equal(other *AvlTreeIterator[K, V]) bool {
+	return it.Iterator.Equal(other.Iterator)
+}
+
+// AvlTree is a self-balancing binary search tree that keeps every node's
+// left and right subtree heights within 1 of each other, rebalancing with
+// single or double rotations after each Insert/Delete.
+type AvlTree[K, V any] struct {
+	root   *Node[K, V]
+	size   int
+	keyCmp Comparator[K]
+}
+
+// New creates a new AvlTree
+func New[K, V any](cmp Comparator[K]) *AvlTree[K, V] {
+	return &AvlTree[K, V]{keyCmp: cmp}
+}
+
+// Clear clears the AvlTree
+func (t *AvlTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Compare compares two keys wrt the AvlTree's key comparator
+func (t *AvlTree[K, V]) Compare(key1, key2 K) int {
+	return t.keyCmp(key1, key2)
+}
+
+// Size returns the size of the AvlTree
+func (t *AvlTree[K, V]) Size() int {
+	return t.size
+}
+
+// Empty returns true if the AvlTree is empty, otherwise returns false
+func (t *AvlTree[K, V]) Empty() bool {
+	return t.size == 0
+}
+
+// Begin returns the node with minimum key in the AvlTree
+func (t *AvlTree[K, V]) Begin() *Node[K, V] {
+	return t.First()
+}
+
+// First returns the node with minimum key in the AvlTree
+func (t *AvlTree[K, V]) First() *Node[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return treeiter.Minimum[*Node[K, V]](t.root)
+}
+
+// RBegin returns the node with maximum key in the AvlTree
+func (t *AvlTree[K, V]) RBegin() *Node[K, V] {
+	return t.Last()
+}
+
+// Last returns the node with maximum key in the AvlTree
+func (t *AvlTree[K, V]) Last() *Node[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return treeiter.Maximum[*Node[K, V]](t.root)
+}
+
+// IterFirst returns the iterator of the first node
+func (t *AvlTree[K, V]) IterFirst() *AvlTreeIterator[K, V] {
+	return NewIterator(t.First())
+}
+
+// IterLast returns the iterator of the last node
+func (t *AvlTree[K, V]) IterLast() *AvlTreeIterator[K, V] {
+	return NewIterator(t.Last())
+}
+
+// Find finds the first node whose key is equal to the passed key, and
+// returns its value
+func (t *AvlTree[K, V]) Find(key K) (V, error) {
+	n := t.findFirstNode(key)
+	if n != nil {
+		return n.value, nil
+	}
+	return *new(V), ErrorNotFound
+}
+
+// FindNode finds the first node whose key is equal to the passed key and
+// returns it
+func (t *AvlTree[K, V]) FindNode(key K) *Node[K, V] {
+	return t.findFirstNode(key)
+}
+
+func (t *AvlTree[K, V]) findFirstNode(key K) *Node[K, V] {
+	node := t.FindLowerBoundNode(key)
+	if node == nil {
+		return nil
+	}
+	if t.keyCmp(node.key, key) == 0 {
+		return node
+	}
+	return nil
+}
+
+// FindLowerBoundNode finds the first node whose key is equal to or
+// greater than the passed key, and returns it
+func (t *AvlTree[K, V]) FindLowerBoundNode(key K) *Node[K, V] {
+	return t.findLowerBoundNode(t.root, key)
+}
+
+func (t *AvlTree[K, V]) findLowerBoundNode(x *Node[K, V], key K) *Node[K, V] {
+	if x == nil {
+		return nil
+	}
+	if t.keyCmp(key, x.key) <= 0 {
+		ret := t.findLowerBoundNode(x.left, key)
+		if ret == nil {
+			return x
+		}
+		if t.keyCmp(ret.key, x.key) <= 0 {
+			return ret
+		}
+		return x
+	}
+	return t.findLowerBoundNode(x.right, key)
+}
+
+// FindUpperBoundNode finds the first node whose key is greater than the
+// passed key, and returns it
+func (t *AvlTree[K, V]) FindUpperBoundNode(key K) *Node[K, V] {
+	return t.findUpperBoundNode(t.root, key)
+}
+
+func (t *AvlTree[K, V]) findUpperBoundNode(x *Node[K, V], key K) *Node[K, V] {
+	if x == nil {
+		return nil
+	}
+	if t.keyCmp(key, x.key) >= 0 {
+		return t.findUpperBoundNode(x.right, key)
+	}
+	ret := t.findUpperBoundNode(x.left, key)
+	if ret == nil {
+		return x
+	}
+	if t.keyCmp(ret.key, x.key) <= 0 {
+		return ret
+	}
+	return x
+}
+
+// Traversal traversals elements in the AvlTree in ascending key order; it
+// stops once the visitor returns false
+func (t *AvlTree[K, V]) Traversal(visit func(key K, value V) bool) {
+	for n := t.First(); n != nil; n = n.Next() {
+		if !visit(n.key, n.value) {
+			break
+		}
+	}
+}
+
+// Insert inserts a key-value pair into the AvlTree
+func (t *AvlTree[K, V]) Insert(key K, value V) {
+	t.size++
+	if t.root == nil {
+		t.root = &Node[K, V]{key: key, value: value, height: 1}
+		return
+	}
+
+	x := t.root
+	var y *Node[K, V]
+	for x != nil {
+		y = x
+		if t.keyCmp(key, x.key) < 0 {
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+
+	z := &Node[K, V]{parent: y, key: key, value: value, height: 1}
+	if t.keyCmp(z.key, y.key) < 0 {
+		y.left = z
+	} else {
+		y.right = z
+	}
+
+	t.retrace(y)
+}
+
+// Delete deletes node from the AvlTree
+func (t *AvlTree[K, V]) Delete(node *Node[K, V]) {
+	z := node
+	if z == nil {
+		return
+	}
+
+	if z.left != nil && z.right != nil {
+		y := treeiter.Successor[*Node[K, V]](z)
+		z.key, z.value = y.key, y.value
+		z = y
+	}
+
+	child := z.left
+	if child == nil {
+		child = z.right
+	}
+
+	parent := z.parent
+	if child != nil {
+		child.parent = parent
+	}
+	if parent == nil {
+		t.root = child
+	} else if parent.left == z {
+		parent.left = child
+	} else {
+		parent.right = child
+	}
+
+	t.size--
+	t.retrace(parent)
+}
+
+// retrace walks from n up to the root, updating heights and rebalancing
+// every node whose balance factor (height(right) - height(left)) has
+// grown past 1 in absolute value.
+func (t *AvlTree[K, V]) retrace(n *Node[K, V]) {
+	for n != nil {
+		n.updateHeight()
+		balance := height(n.right) - height(n.left)
+		switch {
+		case balance > 1:
+			if height(n.right.left) > height(n.right.right) {
+				t.rightRotate(n.right)
+			}
+			n = t.leftRotate(n)
+		case balance < -1:
+			if height(n.left.right) > height(n.left.left) {
+				t.leftRotate(n.left)
+			}
+			n = t.rightRotate(n)
+		}
+		n = n.parent
+	}
+}
+
+// leftRotate rotates x down and to the left, returning the subtree's new
+// root
+func (t *AvlTree[K, V]) leftRotate(x *Node[K, V]) *Node[K, V] {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	y.parent = x.parent
+	if x.parent == nil {
+		t.root = y
+	} else if x == x.parent.left {
+		x.parent.left = y
+	} else {
+		x.parent.right = y
+	}
+	y.left = x
+	x.parent = y
+
+	x.updateHeight()
+	y.updateHeight()
+	return y
+}
+
+// rightRotate rotates x down and to the right, returning the subtree's
+// new root
+func (t *AvlTree[K, V]) rightRotate(x *Node[K, V]) *Node[K, V] {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	y.parent = x.parent
+	if x.parent == nil {
+		t.root = y
+	} else if x == x.parent.right {
+		x.parent.right = y
+	} else {
+		x.parent.left = y
+	}
+	y.right = x
+	x.parent = y
+
+	x.updateHeight()
+	y.updateHeight()
+	return y
+}
+
+// IsAVL reports whether t still satisfies the AVL invariant: every node's
+// stored height is correct, and every node's balance factor is within
+// [-1, 1].
+func (t *AvlTree[K, V]) IsAVL() (bool, error) {
+	_, ok, err := t.test(t.root)
+	return ok, err
+}
+
+func (t *AvlTree[K, V]) test(n *Node[K, V]) (int8, bool, error) {
+	if n == nil {
+		return 0, true, nil
+	}
+	lh, ok, err := t.test(n.left)
+	if !ok {
+		return 0, false, err
+	}
+	rh, ok, err := t.test(n.right)
+	if !ok {
+		return 0, false, err
+	}
+
+	balance := rh - lh
+	if balance > 1 || balance < -1 {
+		return 0, false, fmt.Errorf("node %v has balance factor %d", n.key, balance)
+	}
+
+	h := lh
+	if rh > lh {
+		h = rh
+	}
+	h++
+	if h != n.height {
+		return 0, false, fmt.Errorf("node %v has stored height %d, computed %d", n.key, n.height, h)
+	}
+	return h, true, nil
+}
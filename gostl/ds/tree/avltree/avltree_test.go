@@ -0,0 +1,86 @@
+package avltree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func intCmp(a, b int) int {
+	return a - b
+}
+
+func TestInsertKeepsAVLInvariant(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := New[int, int](intCmp)
+
+	var keys []int
+	for i := 0; i < 500; i++ {
+		k := rnd.Intn(1000)
+		tree.Insert(k, k)
+		keys = append(keys, k)
+
+		if ok, err := tree.IsAVL(); !ok {
+			t.Fatalf("after inserting %d: %v", k, err)
+		}
+	}
+
+	sort.Ints(keys)
+	var got []int
+	tree.Traversal(func(k, v int) bool {
+		got = append(got, k)
+		return true
+	})
+	if len(got) != len(keys) {
+		t.Fatalf("got %d keys, want %d", len(got), len(keys))
+	}
+	for i := range keys {
+		if got[i] != keys[i] {
+			t.Fatalf("in-order traversal mismatch at %d: got %d, want %d", i, got[i], keys[i])
+		}
+	}
+}
+
+func TestDeleteKeepsAVLInvariant(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	tree := New[int, int](intCmp)
+
+	const n = 300
+	for i := 0; i < n; i++ {
+		tree.Insert(i, i)
+	}
+
+	order := rnd.Perm(n)
+	for _, k := range order {
+		node := tree.FindNode(k)
+		if node == nil {
+			t.Fatalf("expected to find key %d before deleting it", k)
+		}
+		tree.Delete(node)
+
+		if ok, err := tree.IsAVL(); !ok {
+			t.Fatalf("after deleting %d: %v", k, err)
+		}
+	}
+
+	if tree.Size() != 0 {
+		t.Fatalf("expected empty tree, got size %d", tree.Size())
+	}
+}
+
+func TestFindLowerUpperBound(t *testing.T) {
+	tree := New[int, int](intCmp)
+	for _, k := range []int{1, 3, 5, 7, 9} {
+		tree.Insert(k, k)
+	}
+
+	if n := tree.FindLowerBoundNode(4); n == nil || n.Key() != 5 {
+		t.Fatalf("expected lower bound of 4 to be 5, got %v", n)
+	}
+	if n := tree.FindUpperBoundNode(5); n == nil || n.Key() != 7 {
+		t.Fatalf("expected upper bound of 5 to be 7, got %v", n)
+	}
+	if _, err := tree.Find(2); err != ErrorNotFound {
+		t.Fatalf("expected ErrorNotFound for missing key, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+// Package treeiter holds the successor/predecessor walk and iterator type
+// shared by every binary search tree in gostl (RbTree, AVL tree, ...), so
+// each implementation only has to supply its node shape instead of
+// reimplementing in-order traversal.
+package treeiter
+
+// NodeShape is the minimal pointer-like shape a tree node must expose for
+// in-order traversal: its left/right children and its parent. N is
+// expected to be a pointer type, so its zero value doubles as "no node".
+type NodeShape[N any] interface {
+	comparable
+	Left() N
+	Right() N
+	Parent() N
+}
+
+// KVNode is a NodeShape that also carries a key and a value, which is all
+// Iterator needs to implement the usual iterator surface.
+type KVNode[N any, K, V any] interface {
+	NodeShape[N]
+	Key() K
+	Value() V
+	SetValue(V)
+}
+
+// Minimum returns the leftmost node of the subtree rooted at n
+func Minimum[N NodeShape[N]](n N) N {
+	for {
+		left := n.Left()
+		var zero N
+		if left == zero {
+			return n
+		}
+		n = left
+	}
+}
+
+// Maximum returns the rightmost node of the subtree rooted at n
+func Maximum[N NodeShape[N]](n N) N {
+	for {
+		right := n.Right()
+		var zero N
+		if right == zero {
+			return n
+		}
+		n = right
+	}
+}
+
+// Successor returns the in-order successor of x, or the zero value of N if
+// x is the last node
+func Successor[N NodeShape[N]](x N) N {
+	var zero N
+	if x.Right() != zero {
+		return Minimum[N](x.Right())
+	}
+	y := x.Parent()
+	for y != zero && x == y.Right() {
+		x = y
+		y = x.Parent()
+	}
+	return y
+}
+
+// Predecessor returns the in-order predecessor of x, or the zero value of
+// N if x is the first node
+func Predecessor[N NodeShape[N]](x N) N {
+	var zero N
+	if x.Left() != zero {
+		return Maximum[N](x.Left())
+	}
+	p := x.Parent()
+	if p == zero {
+		return zero
+	}
+	if p.Right() == x {
+		return p
+	}
+	for p != zero && p.Left() == x {
+		x = p
+		p = x.Parent()
+	}
+	return p
+}
+
+// Iterator is a bidirectional in-order iterator over any tree whose nodes
+// implement KVNode. RbTreeIterator and the AVL tree's iterator both wrap
+// this type instantiated with their own node type.
+type Iterator[N KVNode[N, K, V], K, V any] struct {
+	node N
+}
+
+// NewIterator creates an Iterator positioned at node
+func NewIterator[N KVNode[N, K, V], K, V any](node N) *Iterator[N, K, V] {
+	return &Iterator[N, K, V]{node: node}
+}
+
+// IsValid returns true if the iterator is positioned at a node
+func (it *Iterator[N, K, V]) IsValid() bool {
+	var zero N
+	return it.node != zero
+}
+
+// Next moves the iterator to its successor, and returns itself
+func (it *Iterator[N, K, V]) Next() *Iterator[N, K, V] {
+	if it.IsValid() {
+		it.node = Successor[N](it.node)
+	}
+	return it
+}
+
+// Prev moves the iterator to its predecessor, and returns itself
+func (it *Iterator[N, K, V]) Prev() *Iterator[N, K, V] {
+	if it.IsValid() {
+		it.node = Predecessor[N](it.node)
+	}
+	return it
+}
+
+// Key returns the key of the node the iterator points to
+func (it *Iterator[N, K, V]) Key() K {
+	return it.node.Key()
+}
+
+// Value returns the value of the node the iterator points to
+func (it *Iterator[N, K, V]) Value() V {
+	return it.node.Value()
+}
+
+// SetValue sets the value of the node the iterator points to
+func (it *Iterator[N, K, V]) SetValue(val V) {
+	it.node.SetValue(val)
+}
+
+// Clone clones the iterator into a new, independent Iterator
+func (it *Iterator[N, K, V]) Clone() *Iterator[N, K, V] {
+	return NewIterator[N, K, V](it.node)
+}
+
+// Equal returns true if it and other point to the same node
+func (it *Iterator[N, K, V]) Equal(other *Iterator[N, K, V]) bool {
+	return it.node == other.node
+}
@@ -3,7 +3,6 @@ package gostl
 import (
 	"github.com/RajjjAryan/gostl/ds/container"
 	"github.com/RajjjAryan/gostl/ds/deque"
-	"github.com/RajjjAryan/gostl/ds/list/bidlist"
 )
 
 var (
@@ -29,7 +28,16 @@ func WithContainer[T any](c container.Container[T]) QOption[T] {
 // WithListContainer is used to set List as a Queue's underlying container
 func WithListContainer[T any]() QOption[T] {
 	return func(option *QOptions[T]) {
-		option.container = bidlist.New[T]()
+		option.container = newListContainer[T]()
+	}
+}
+
+// WithRingBufferContainer is used to set a fixed-capacity ring buffer as
+// a Queue's underlying container; once it holds capacity elements,
+// further Push calls are silently dropped instead of growing the queue
+func WithRingBufferContainer[T any](capacity int) QOption[T] {
+	return func(option *QOptions[T]) {
+		option.container = newRingBufferContainer[T](capacity)
 	}
 }
 
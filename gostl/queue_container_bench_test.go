@@ -0,0 +1,30 @@
+package gostl
+
+import "testing"
+
+// benchmarkQueueContainer pushes then pops n values in a tight
+// producer/consumer loop, simulating a worker-pool queue under load.
+func benchmarkQueueContainer(b *testing.B, q *Queue[int]) {
+	const n = 1000
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for v := 0; v < n; v++ {
+			q.Push(v)
+		}
+		for v := 0; v < n; v++ {
+			q.Pop()
+		}
+	}
+}
+
+func BenchmarkQueueContainer(b *testing.B) {
+	b.Run("Deque", func(b *testing.B) {
+		benchmarkQueueContainer(b, NewQueue[int]())
+	})
+	b.Run("BidList", func(b *testing.B) {
+		benchmarkQueueContainer(b, NewQueue[int](WithListContainer[int]()))
+	})
+	b.Run("RingBuffer", func(b *testing.B) {
+		benchmarkQueueContainer(b, NewQueue[int](WithRingBufferContainer[int](1000)))
+	})
+}
@@ -4,71 +4,48 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/RajjjAryan/gostl/ds/container"
+	"github.com/RajjjAryan/gostl/internal/treeiter"
 	"github.com/RajjjAryan/gostl/utils/visitor"
+	"golang.org/x/exp/constraints"
 )
 
-// RbTreeIterator is an iterator implementation of RbTree
+// Comparator compares two keys, returning a negative number if a < b, zero
+// if a == b, and a positive number if a > b.
+type Comparator[K any] func(a, b K) int
+
+// RbTreeIterator is an iterator implementation of RbTree. It wraps the
+// tree-shape-agnostic iterator in treeiter, which the AVL tree's iterator
+// wraps too.
 type RbTreeIterator[K, V any] struct {
-	node *Node[K, V]
+	*treeiter.Iterator[*Node[K, V], K, V]
 }
 
 // NewIterator creates a RbTreeIterator from the passed node
 func NewIterator[K, V any](node *Node[K, V]) *RbTreeIterator[K, V] {
-	return &RbTreeIterator[K, V]{node: node}
-}
-
-// IsValid returns true if the iterator is valid, otherwise returns false
-func (iter *RbTreeIterator[K, V]) IsValid() bool {
-	return iter.node != nil
-}
-
-// Next moves the pointer of the iterator to the next node, and returns itself
-func (iter *RbTreeIterator[K, V]) Next() ConstIterator[V] {
-	if iter.IsValid() {
-		iter.node = iter.node.Next()
-	}
-	return iter
-}
-
-// Prev moves the pointer of the iterator to the previous node, and returns itself
-func (iter *RbTreeIterator[K, V]) Prev() ConstBidIterator[V] {
-	if iter.IsValid() {
-		iter.node = iter.node.Prev()
-	}
-	return iter
-}
-
-// Key returns the node's key of the iterator point to
-func (iter *RbTreeIterator[K, V]) Key() K {
-	return iter.node.Key()
+	return &RbTreeIterator[K, V]{treeiter.NewIterator[*Node[K, V], K, V](node)}
 }
 
-// Value returns the node's value of the iterator point to
-func (iter *RbTreeIterator[K, V]) Value() V {
-	return iter.node.Value()
+// Next moves the iterator to its successor, and returns itself
+func (it *RbTreeIterator[K, V]) Next() *RbTreeIterator[K, V] {
+	it.Iterator.Next()
+	return it
 }
 
-// SetValue sets the node's value of the iterator point to
-func (iter *RbTreeIterator[K, V]) SetValue(val V) error {
-	iter.node.SetValue(val)
-	return nil
+// Prev moves the iterator to its predecessor, and returns itself
+func (it *RbTreeIterator[K, V]) Prev() *RbTreeIterator[K, V] {
+	it.Iterator.Prev()
+	return it
 }
 
-// Clone clones the iterator into a new RbTreeIterator
-func (iter *RbTreeIterator[K, V]) Clone() ConstIterator[V] {
-	return NewIterator(iter.node)
+// Clone clones the iterator into a new, independent RbTreeIterator
+func (it *RbTreeIterator[K, V]) Clone() *RbTreeIterator[K, V] {
+	return &RbTreeIterator[K, V]{it.Iterator.Clone()}
 }
 
-// Equal returns true if the iterator is equal to the passed iterator
-func (iter *RbTreeIterator[K, V]) Equal(other ConstIterator[V]) bool {
-	otherIter, ok := other.(*RbTreeIterator[K, V])
-	if !ok {
-		return false
-	}
-	if otherIter.node == iter.node {
-		return true
-	}
-	return false
+// Equal returns true if it and other point to the same node
+func (it *RbTreeIterator[K, V]) Equal(other *RbTreeIterator[K, V]) bool {
+	return it.Iterator.Equal(other.Iterator)
 }
 
 // Color defines node color type
@@ -107,62 +84,51 @@ func (n *Node[K, V]) SetValue(val V) {
 
 // Next returns the Node's successor as an iterator.
 func (n *Node[K, V]) Next() *Node[K, V] {
-	return successor(n)
+	return treeiter.Successor[*Node[K, V]](n)
 }
 
 // Prev returns the Node's predecessor as an iterator.
 func (n *Node[K, V]) Prev() *Node[K, V] {
-	return presuccessor(n)
+	return treeiter.Predecessor[*Node[K, V]](n)
 }
 
-// successor returns the successor of the Node
-func successor[K, V any](x *Node[K, V]) *Node[K, V] {
-	if x.right != nil {
-		return minimum(x.right)
-	}
-	y := x.parent
-	for y != nil && x == y.right {
-		x = y
-		y = x.parent
-	}
-	return y
+// Parent returns the Node's parent, or nil if n is the root
+func (n *Node[K, V]) Parent() *Node[K, V] {
+	return n.parent
 }
 
-// presuccessor returns the presuccessor of the Node
-func presuccessor[K, V any](x *Node[K, V]) *Node[K, V] {
-	if x.left != nil {
-		return maximum(x.left)
-	}
-	if x.parent != nil {
-		if x.parent.right == x {
-			return x.parent
-		}
-		for x.parent != nil && x.parent.left == x {
-			x = x.parent
-		}
-		return x.parent
-	}
-	return nil
+// Left returns the Node's left child, or nil if it has none
+func (n *Node[K, V]) Left() *Node[K, V] {
+	return n.left
+}
+
+// Right returns the Node's right child, or nil if it has none
+func (n *Node[K, V]) Right() *Node[K, V] {
+	return n.right
 }
 
 // minimum finds the minimum Node of subtree n.
 func minimum[K any, V any](n *Node[K, V]) *Node[K, V] {
-	for n.left != nil {
-		n = n.left
-	}
-	return n
+	return treeiter.Minimum[*Node[K, V]](n)
 }
 
 // maximum finds the maximum Node of subtree n.
 func maximum[K any, V any](n *Node[K, V]) *Node[K, V] {
-	for n.right != nil {
-		n = n.right
-	}
-	return n
+	return treeiter.Maximum[*Node[K, V]](n)
 }
 
 var ErrorNotFound = errors.New("not found")
 
+// Augmentor lets a caller maintain auxiliary per-node data (e.g. a subtree
+// aggregate such as an interval tree's MaxEnd) as the RbTree's shape changes.
+// OnUpdate is invoked, bottom-up, for every node whose left or right child
+// may have changed - that covers both rotations and the structural changes
+// made by Insert/Delete, without the RbTree having to know what the
+// augmented data actually is.
+type Augmentor[K, V any] interface {
+	OnUpdate(n, left, right *Node[K, V])
+}
+
 // RbTree is a kind of self-balancing binary search tree in computer science.
 // Each node of the binary tree has an extra bit, and that bit is often interpreted
 // as the color (red or black) of the node. These color bits are used to ensure the tree
@@ -171,6 +137,7 @@ type RbTree[K, V any] struct {
 	root   *Node[K, V]
 	size   int
 	keyCmp Comparator[K]
+	aug    Augmentor[K, V]
 }
 
 // New creates a new RbTree
@@ -178,6 +145,38 @@ func New[K, V any](cmp Comparator[K]) *RbTree[K, V] {
 	return &RbTree[K, V]{keyCmp: cmp}
 }
 
+// NewOrdered creates a new RbTree keyed by a container.Ordered type, so
+// callers don't have to build a Comparator closure by hand.
+func NewOrdered[K container.Ordered[K], V any]() *RbTree[K, V] {
+	return New[K, V](func(a, b K) int { return a.Compare(b) })
+}
+
+// NewNative creates a new RbTree keyed by a constraints.Ordered type (any
+// built-in numeric or string type), comparing keys with < and ==.
+func NewNative[K constraints.Ordered, V any]() *RbTree[K, V] {
+	return New[K, V](container.NativeCompare[K])
+}
+
+// NewWithAugmentor creates a new RbTree that keeps aug informed of every
+// structural change, so it can recompute node-local aggregate data without
+// forking the RbTree implementation.
+func NewWithAugmentor[K, V any](cmp Comparator[K], aug Augmentor[K, V]) *RbTree[K, V] {
+	return &RbTree[K, V]{keyCmp: cmp, aug: aug}
+}
+
+// updateAugPath walks from n up to the root, calling the Augmentor on every
+// node along the way so ancestors can recompute their aggregate from their
+// (already up to date) children.
+func (t *RbTree[K, V]) updateAugPath(n *Node[K, V]) {
+	if t.aug == nil {
+		return
+	}
+	for n != nil {
+		t.aug.OnUpdate(n, n.left, n.right)
+		n = n.parent
+	}
+}
+
 // Clear clears the RbTree
 func (t *RbTree[K, V]) Clear() {
 	t.root = nil
@@ -203,6 +202,11 @@ func (t *RbTree[K, V]) Compare(key1, key2 K) int {
 	return t.keyCmp(key1, key2)
 }
 
+// Root returns the root node of the RbTree, or nil if it is empty
+func (t *RbTree[K, V]) Root() *Node[K, V] {
+	return t.root
+}
+
 // Begin returns the node with minimum key in the RbTree
 func (t *RbTree[K, V]) Begin() *Node[K, V] {
 	return t.First()
@@ -269,6 +273,7 @@ func (t *RbTree[K, V]) Insert(key K, value V) {
 	if y == nil {
 		z.color = BLACK
 		t.root = z
+		t.updateAugPath(z)
 		return
 	} else if t.keyCmp(z.key, y.key) < 0 {
 		y.left = z
@@ -276,6 +281,7 @@ func (t *RbTree[K, V]) Insert(key K, value V) {
 		y.right = z
 	}
 	t.rbInsertFixup(z)
+	t.updateAugPath(z)
 }
 
 func (t *RbTree[K, V]) rbInsertFixup(z *Node[K, V]) {
@@ -327,7 +333,7 @@ func (t *RbTree[K, V]) Delete(node *Node[K, V]) {
 
 	var x, y *Node[K, V]
 	if z.left != nil && z.right != nil {
-		y = successor(z)
+		y = treeiter.Successor[*Node[K, V]](z)
 	} else {
 		y = z
 	}
@@ -359,6 +365,7 @@ func (t *RbTree[K, V]) Delete(node *Node[K, V]) {
 		t.rbDeleteFixup(x, xparent)
 	}
 	t.size--
+	t.updateAugPath(xparent)
 }
 
 func (t *RbTree[K, V]) rbDeleteFixup(x, parent *Node[K, V]) {
@@ -456,6 +463,11 @@ func (t *RbTree[K, V]) leftRotate(x *Node[K, V]) {
 	}
 	y.left = x
 	x.parent = y
+
+	// x's children changed (it lost y's old left subtree) and y's did too
+	// (it gained x), so both need their aggregate recomputed; updateAugPath
+	// does x first, then walks up through y and beyond.
+	t.updateAugPath(x)
 }
 
 func (t *RbTree[K, V]) rightRotate(x *Node[K, V]) {
@@ -474,6 +486,11 @@ func (t *RbTree[K, V]) rightRotate(x *Node[K, V]) {
 	}
 	y.right = x
 	x.parent = y
+
+	// x's children changed (it lost y's old right subtree) and y's did too
+	// (it gained x), so both need their aggregate recomputed; updateAugPath
+	// does x first, then walks up through y and beyond.
+	t.updateAugPath(x)
 }
 
 // findNode finds the node that its key is equal to the passed key, and returns it.
@@ -0,0 +1,207 @@
+package gostl
+
+import "github.com/RajjjAryan/gostl/utils/visitor"
+
+// RangeTraversal traversals every key in [lo, hi), in ascending order,
+// stopping early if visitor returns false
+func (t *RbTree[K, V]) RangeTraversal(lo, hi K, visit visitor.KvVisitor[K, V]) {
+	for n := t.FindLowerBoundNode(lo); n != nil && t.keyCmp(n.key, hi) < 0; n = n.Next() {
+		if !visit(n.key, n.value) {
+			break
+		}
+	}
+}
+
+// DeleteRange removes every key in [lo, hi) and returns how many nodes
+// were removed. Nodes are re-located and deleted one at a time, since
+// Delete may splice out a different node than the one it's called with
+// (it moves an in-order successor's key/value into place), which would
+// invalidate a batch of Node pointers collected up front.
+func (t *RbTree[K, V]) DeleteRange(lo, hi K) int {
+	count := 0
+	for {
+		n := t.FindLowerBoundNode(lo)
+		if n == nil || t.keyCmp(n.key, hi) >= 0 {
+			break
+		}
+		t.Delete(n)
+		count++
+	}
+	return count
+}
+
+// DeleteAll removes every node whose key equals key and returns how many
+// were removed, unlike Delete/findFirstNode which only ever touch one
+// duplicate at a time
+func (t *RbTree[K, V]) DeleteAll(key K) int {
+	count := 0
+	for {
+		n := t.findFirstNode(key)
+		if n == nil {
+			break
+		}
+		t.Delete(n)
+		count++
+	}
+	return count
+}
+
+// Clone duplicates the RbTree's structure in O(n), preserving keys,
+// values and colors. The clone does not inherit the original's Augmentor,
+// since an Augmentor's external state (if any) is tied to the tree
+// instance it was built for.
+func (t *RbTree[K, V]) Clone() *RbTree[K, V] {
+	clone := &RbTree[K, V]{keyCmp: t.keyCmp, size: t.size}
+	clone.root = cloneNode[K, V](t.root, nil)
+	return clone
+}
+
+func cloneNode[K, V any](n, parent *Node[K, V]) *Node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := &Node[K, V]{parent: parent, color: n.color, key: n.key, value: n.value}
+	c.left = cloneNode(n.left, c)
+	c.right = cloneNode(n.right, c)
+	return c
+}
+
+// Merge absorbs every key-value pair of other into t. If every key in t is
+// less than every key in other (or vice versa), the two trees are joined
+// in O(log n) extra work on top of splicing the shorter tree onto the
+// taller one's spine. Otherwise the ranges overlap and Merge falls back to
+// inserting other's entries into t one at a time. other is left empty.
+func (t *RbTree[K, V]) Merge(other *RbTree[K, V]) {
+	if other == nil || other.root == nil {
+		return
+	}
+	if t.root == nil {
+		t.root, t.size = other.root, other.size
+		other.root, other.size = nil, 0
+		return
+	}
+
+	switch {
+	case t.keyCmp(t.Last().key, other.First().key) < 0:
+		t.joinDisjoint(other, false)
+	case t.keyCmp(other.Last().key, t.First().key) < 0:
+		t.joinDisjoint(other, true)
+	default:
+		other.Traversal(func(k K, v V) bool {
+			t.Insert(k, v)
+			return true
+		})
+		other.Clear()
+	}
+}
+
+// joinDisjoint merges other into t, assuming every key in one tree
+// precedes every key in the other. tIsHigh reports whether t's keys all
+// come after other's.
+func (t *RbTree[K, V]) joinDisjoint(other *RbTree[K, V], tIsHigh bool) {
+	low, high := t, other
+	if tIsHigh {
+		low, high = other, t
+	}
+	totalSize := t.size + other.size
+
+	// Pull low's maximum out to use as the bridging node between the two
+	// subtrees, rather than allocating a new one.
+	pivot := low.Last()
+	low.Delete(pivot)
+
+	leftRoot, rightRoot := low.root, high.root
+	lh, rh := blackHeight(leftRoot), blackHeight(rightRoot)
+
+	switch {
+	case lh == rh:
+		pivot.left, pivot.right = leftRoot, rightRoot
+		if leftRoot != nil {
+			leftRoot.parent = pivot
+		}
+		if rightRoot != nil {
+			rightRoot.parent = pivot
+		}
+		pivot.parent = nil
+		pivot.color = BLACK
+		t.root = pivot
+	case lh > rh:
+		parent := descendRightSpine(leftRoot, lh, rh)
+		pivot.left, pivot.right = parent.right, rightRoot
+		if parent.right != nil {
+			parent.right.parent = pivot
+		}
+		if rightRoot != nil {
+			rightRoot.parent = pivot
+		}
+		pivot.color = RED
+		pivot.parent = parent
+		parent.right = pivot
+		t.root = leftRoot
+		t.rbInsertFixup(pivot)
+	default:
+		parent := descendLeftSpine(rightRoot, lh, rh)
+		pivot.right, pivot.left = parent.left, leftRoot
+		if parent.left != nil {
+			parent.left.parent = pivot
+		}
+		if leftRoot != nil {
+			leftRoot.parent = pivot
+		}
+		pivot.color = RED
+		pivot.parent = parent
+		parent.left = pivot
+		t.root = rightRoot
+		t.rbInsertFixup(pivot)
+	}
+
+	t.size = totalSize
+	other.root, other.size = nil, 0
+}
+
+// blackHeight returns the number of black nodes on n's leftmost
+// root-to-nil path, which by the RbTree invariant equals the black count
+// on every root-to-nil path.
+func blackHeight[K, V any](n *Node[K, V]) int {
+	h := 0
+	for n != nil {
+		if n.color {
+			h++
+		}
+		n = n.left
+	}
+	return h
+}
+
+// descendRightSpine walks down n's right spine to the first black node
+// whose subtree has black height rh and returns that node's parent, so a
+// pivot of black height rh can take the node's place as the parent's right
+// child, with the node as the pivot's left child.
+func descendRightSpine[K, V any](n *Node[K, V], lh, rh int) *Node[K, V] {
+	h := lh
+	for n.right != nil {
+		if n.color {
+			h--
+		}
+		if n.right.color && h == rh {
+			return n
+		}
+		n = n.right
+	}
+	return n
+}
+
+// descendLeftSpine is the mirror of descendRightSpine along the left spine
+func descendLeftSpine[K, V any](n *Node[K, V], lh, rh int) *Node[K, V] {
+	h := rh
+	for n.left != nil {
+		if n.color {
+			h--
+		}
+		if n.left.color && h == lh {
+			return n
+		}
+		n = n.left
+	}
+	return n
+}
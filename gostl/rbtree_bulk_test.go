@@ -0,0 +1,185 @@
+package gostl
+
+import (
+	"testing"
+)
+
+func intKeyCmp(a, b int) int {
+	return a - b
+}
+
+func newIntTree(values ...int) *RbTree[int, int] {
+	t := New[int, int](intKeyCmp)
+	for _, v := range values {
+		t.Insert(v, v)
+	}
+	return t
+}
+
+func collect(t *RbTree[int, int]) []int {
+	var got []int
+	t.Traversal(func(k, v int) bool {
+		got = append(got, k)
+		return true
+	})
+	return got
+}
+
+func assertEqualInts(t *testing.T, got, want []int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestRangeTraversal(t *testing.T) {
+	tree := newIntTree(1, 3, 5, 7, 9, 11)
+
+	var got []int
+	tree.RangeTraversal(3, 9, func(k, v int) bool {
+		got = append(got, k)
+		return true
+	})
+
+	assertEqualInts(t, got, []int{3, 5, 7})
+}
+
+func TestDeleteRange(t *testing.T) {
+	tree := newIntTree(1, 3, 5, 7, 9, 11)
+
+	n := tree.DeleteRange(3, 9)
+	if n != 3 {
+		t.Fatalf("expected 3 deletions, got %d", n)
+	}
+	assertEqualInts(t, collect(tree), []int{1, 9, 11})
+
+	if ok, err := tree.IsRbTree(); !ok {
+		t.Fatalf("tree invariants broken after DeleteRange: %v", err)
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	tree := New[int, int](intKeyCmp)
+	for _, v := range []int{5, 5, 5, 1, 9} {
+		tree.Insert(v, v)
+	}
+
+	n := tree.DeleteAll(5)
+	if n != 3 {
+		t.Fatalf("expected 3 deletions, got %d", n)
+	}
+	assertEqualInts(t, collect(tree), []int{1, 9})
+}
+
+func TestClone(t *testing.T) {
+	orig := newIntTree(1, 2, 3, 4, 5, 6, 7)
+	clone := orig.Clone()
+
+	assertEqualInts(t, collect(clone), collect(orig))
+	if ok, err := clone.IsRbTree(); !ok {
+		t.Fatalf("clone violates RbTree invariants: %v", err)
+	}
+
+	clone.Insert(100, 100)
+	if orig.Size() == clone.Size() {
+		t.Fatalf("expected clone to be independent of the original")
+	}
+}
+
+func TestMergeDisjoint(t *testing.T) {
+	for _, size := range []struct{ lo, hi int }{{1, 50}, {1, 500}} {
+		low := newIntTreeRange(1, size.lo)
+		high := newIntTreeRange(size.lo+1, size.hi)
+		wantSize := low.Size() + high.Size()
+
+		low.Merge(high)
+
+		if low.Size() != wantSize {
+			t.Fatalf("expected merged size %d, got %d", wantSize, low.Size())
+		}
+		if high.Size() != 0 {
+			t.Fatalf("expected other to be drained after Merge, got size %d", high.Size())
+		}
+		if ok, err := low.IsRbTree(); !ok {
+			t.Fatalf("merged tree violates RbTree invariants: %v", err)
+		}
+
+		want := make([]int, 0, wantSize)
+		for i := 1; i <= size.hi; i++ {
+			want = append(want, i)
+		}
+		assertEqualInts(t, collect(low), want)
+	}
+}
+
+func TestMergeOverlappingFallsBackToInsertion(t *testing.T) {
+	a := newIntTree(1, 2, 3)
+	b := newIntTree(2, 3, 4)
+
+	a.Merge(b)
+
+	assertEqualInts(t, collect(a), []int{1, 2, 2, 3, 3, 4})
+	if ok, err := a.IsRbTree(); !ok {
+		t.Fatalf("merged tree violates RbTree invariants: %v", err)
+	}
+}
+
+func newIntTreeRange(lo, hi int) *RbTree[int, int] {
+	t := New[int, int](intKeyCmp)
+	for i := lo; i <= hi; i++ {
+		t.Insert(i, i)
+	}
+	return t
+}
+
+func BenchmarkClone(b *testing.B) {
+	tree := newIntTreeRange(1, 10000)
+
+	b.Run("Clone", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = tree.Clone()
+		}
+	})
+
+	b.Run("NaiveReinsert", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			clone := New[int, int](intKeyCmp)
+			tree.Traversal(func(k, v int) bool {
+				clone.Insert(k, v)
+				return true
+			})
+		}
+	})
+}
+
+func BenchmarkMergeDisjoint(b *testing.B) {
+	b.Run("Merge", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			low := newIntTreeRange(1, 5000)
+			high := newIntTreeRange(5001, 10000)
+			b.StartTimer()
+
+			low.Merge(high)
+		}
+	})
+
+	b.Run("NaiveReinsert", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			low := newIntTreeRange(1, 5000)
+			high := newIntTreeRange(5001, 10000)
+			b.StartTimer()
+
+			high.Traversal(func(k, v int) bool {
+				low.Insert(k, v)
+				return true
+			})
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package gostl
+
+import "testing"
+
+func TestNewNative(t *testing.T) {
+	tree := NewNative[int, string]()
+	tree.Insert(2, "b")
+	tree.Insert(1, "a")
+	tree.Insert(3, "c")
+
+	var got []int
+	tree.Traversal(func(k int, v string) bool {
+		got = append(got, k)
+		return true
+	})
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+// orderedInt wraps int to implement container.Ordered[orderedInt].
+type orderedInt int
+
+func (o orderedInt) Compare(other orderedInt) int {
+	switch {
+	case o < other:
+		return -1
+	case o > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestNewOrdered(t *testing.T) {
+	tree := NewOrdered[orderedInt, string]()
+	tree.Insert(2, "b")
+	tree.Insert(1, "a")
+	tree.Insert(3, "c")
+
+	var got []orderedInt
+	tree.Traversal(func(k orderedInt, v string) bool {
+		got = append(got, k)
+		return true
+	})
+	want := []orderedInt{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
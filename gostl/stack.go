@@ -19,6 +19,31 @@ type SOptions[T any] struct {
 // SOption is a function type used to set SOptions
 type SOption[T any] func(option *SOptions[T])
 
+// WithStackContainer is used to set a Stack's underlying container
+func WithStackContainer[T any](c container.Container[T]) SOption[T] {
+	return func(option *SOptions[T]) {
+		option.container = c
+	}
+}
+
+// WithStackListContainer is used to set List as a Stack's underlying
+// container
+func WithStackListContainer[T any]() SOption[T] {
+	return func(option *SOptions[T]) {
+		option.container = newListContainer[T]()
+	}
+}
+
+// WithStackRingBufferContainer is used to set a fixed-capacity ring
+// buffer as a Stack's underlying container; once it holds capacity
+// elements, further Push calls are silently dropped instead of growing
+// the stack
+func WithStackRingBufferContainer[T any](capacity int) SOption[T] {
+	return func(option *SOptions[T]) {
+		option.container = newRingBufferContainer[T](capacity)
+	}
+}
+
 // Stack is a last-in-first-out data structure
 type Stack[T any] struct {
 	container container.Container[T]